@@ -0,0 +1,50 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// handleEvents serves GET /games/{gameID}/events: a Server-Sent Events
+// stream of that game's live match and score updates, closing when the
+// client disconnects.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	gameID := muxVar(r, "gameID")
+
+	gameService, ok := s.gameServices.Get(gameID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "game is not currently being played")
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	events := gameService.Events(r.Context())
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload)
+			flusher.Flush()
+		}
+	}
+}
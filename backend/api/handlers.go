@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"liguain/backend/models"
+)
+
+// handleSchedule serves GET /leagues/{leagueID}/schedule: the league's
+// upcoming matches grouped by kickoff day.
+func (s *Server) handleSchedule(w http.ResponseWriter, r *http.Request) {
+	leagueID := muxVar(r, "leagueID")
+
+	matches, err := s.schedule.UpcomingMatches(leagueID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to load schedule")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, groupByDay(matches))
+}
+
+// gameStatusResponse is the body of GET /games/{gameID}.
+type gameStatusResponse struct {
+	GameID      string         `json:"gameId"`
+	Finished    bool           `json:"finished"`
+	Leaderboard map[string]int `json:"leaderboard"`
+}
+
+// handleGame serves GET /games/{gameID}: status, leaderboard and finished
+// flag for a game.
+func (s *Server) handleGame(w http.ResponseWriter, r *http.Request) {
+	gameID := muxVar(r, "gameID")
+
+	game, err := s.games.GetGame(gameID)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	leaderboard := make(map[string]int)
+	for player, points := range game.Scores() {
+		leaderboard[player.Name] = points
+	}
+
+	writeJSON(w, http.StatusOK, gameStatusResponse{
+		GameID:      gameID,
+		Finished:    game.IsFinished(),
+		Leaderboard: leaderboard,
+	})
+}
+
+// placeBetRequest is the body of POST /games/{gameID}/bets.
+type placeBetRequest struct {
+	PlayerID  string `json:"playerID"`
+	MatchID   string `json:"matchID"`
+	HomeGoals int    `json:"homeGoals"`
+	AwayGoals int    `json:"awayGoals"`
+}
+
+// handlePostBet serves POST /games/{gameID}/bets: places a bet on behalf
+// of the authenticated player, rejecting it with 409 if the match has
+// already kicked off and with 403 if the body's playerID is not the
+// authenticated player.
+func (s *Server) handlePostBet(w http.ResponseWriter, r *http.Request) {
+	gameID := muxVar(r, "gameID")
+
+	var req placeBetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	game, err := s.games.GetGame(gameID)
+	if err != nil {
+		writeError(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	var match models.Match
+	for _, m := range game.Matches() {
+		if m.Id() == req.MatchID {
+			match = m
+			break
+		}
+	}
+	if match == nil {
+		writeError(w, http.StatusNotFound, "match not found")
+		return
+	}
+
+	now := time.Now()
+	if !now.Before(match.Time()) {
+		writeError(w, http.StatusConflict, "match has already started")
+		return
+	}
+
+	gameService, ok := s.gameServices.Get(gameID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "game is not currently being played")
+		return
+	}
+
+	session := sessionFromContext(r.Context())
+	sessionID := ""
+	if session != nil {
+		if session.PlayerID != req.PlayerID {
+			writeError(w, http.StatusForbidden, "playerID does not match the authenticated player")
+			return
+		}
+		sessionID = session.ID
+	}
+
+	bet := models.NewBet(match, req.HomeGoals, req.AwayGoals)
+	gameService.PlaceBetFromSession(bet, models.Player{Name: req.PlayerID}, sessionID, now)
+
+	writeJSON(w, http.StatusCreated, map[string]string{"status": "accepted"})
+}
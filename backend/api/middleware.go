@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"liguain/backend/services"
+)
+
+type contextKey string
+
+const sessionContextKey contextKey = "session"
+
+// loggingMiddleware logs every request's method, path and latency.
+func loggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		log.Infof("%s %s (%s)", r.Method, r.URL.Path, time.Since(start))
+	})
+}
+
+// authMiddleware registers (or refreshes) the caller's PlayerSession via
+// players, using the X-Player-ID and X-Player-Client headers, and stashes
+// the resulting session on the request context for handlers to read with
+// sessionFromContext. trustedProxies is forwarded to clientIP so a spoofed
+// X-Forwarded-For header from an untrusted peer can't forge a session's IP.
+func authMiddleware(players *services.Players, trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			playerID := r.Header.Get("X-Player-ID")
+			if playerID == "" {
+				http.Error(w, "missing X-Player-ID header", http.StatusUnauthorized)
+				return
+			}
+			client := r.Header.Get("X-Player-Client")
+			gameID := muxVar(r, "gameID")
+
+			session, err := players.Register(r.Context(), gameID, playerID, client, r.UserAgent(), clientIP(r, trustedProxies))
+			if err != nil {
+				http.Error(w, "failed to register session", http.StatusInternalServerError)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), sessionContextKey, session)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func sessionFromContext(ctx context.Context) *services.PlayerSession {
+	session, _ := ctx.Value(sessionContextKey).(*services.PlayerSession)
+	return session
+}
+
+// ParseTrustedProxies parses cidrs (e.g. "10.0.0.0/8") into the form
+// clientIP expects, so callers can build their trusted-proxy list once at
+// startup from config instead of passing raw strings around.
+func ParseTrustedProxies(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		networks[i] = network
+	}
+	return networks, nil
+}
+
+// clientIP returns the caller's address: the first X-Forwarded-For entry, or
+// otherwise r.RemoteAddr as is (host:port). X-Forwarded-For is only trusted
+// when r.RemoteAddr (the immediate peer) falls within trustedProxies; an
+// arbitrary internet caller can set any X-Forwarded-For value it likes, so
+// trusting it unconditionally would let them impersonate any IP.
+func clientIP(r *http.Request, trustedProxies []*net.IPNet) string {
+	forwarded := r.Header.Get("X-Forwarded-For")
+	if forwarded == "" || !isTrustedProxy(r.RemoteAddr, trustedProxies) {
+		return r.RemoteAddr
+	}
+
+	// The header may carry a comma-separated chain of proxies; the first
+	// entry is the one the originating client set.
+	client, _, _ := strings.Cut(forwarded, ",")
+	return strings.TrimSpace(client)
+}
+
+func isTrustedProxy(remoteAddr string, trustedProxies []*net.IPNet) bool {
+	host := remoteAddr
+	if h, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		host = h
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
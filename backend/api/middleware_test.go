@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8"})
+	if err != nil {
+		t.Fatalf("Failed to parse trusted proxies: %v", err)
+	}
+
+	cases := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{
+			name:       "no X-Forwarded-For falls back to RemoteAddr",
+			remoteAddr: "203.0.113.5:1234",
+			want:       "203.0.113.5:1234",
+		},
+		{
+			name:       "X-Forwarded-For from an untrusted peer is ignored",
+			remoteAddr: "203.0.113.5:1234",
+			forwarded:  "198.51.100.9",
+			want:       "203.0.113.5:1234",
+		},
+		{
+			name:       "X-Forwarded-For from a trusted proxy is used",
+			remoteAddr: "10.1.2.3:1234",
+			forwarded:  "198.51.100.9",
+			want:       "198.51.100.9",
+		},
+		{
+			name:       "only the first hop of a forwarded chain is trusted",
+			remoteAddr: "10.1.2.3:1234",
+			forwarded:  "198.51.100.9, 10.1.2.3",
+			want:       "198.51.100.9",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tc.forwarded)
+			}
+
+			if got := clientIP(req, trustedProxies); got != tc.want {
+				t.Errorf("clientIP() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"sort"
+
+	"liguain/backend/models"
+)
+
+// ScheduleProvider looks up the upcoming matches for a league, so Server
+// doesn't need to know how schedules are fetched or cached.
+type ScheduleProvider interface {
+	UpcomingMatches(leagueID string) ([]models.Match, error)
+}
+
+// groupByDay buckets matches by their kickoff date (YYYY-MM-DD, UTC), with
+// each bucket sorted by kickoff time.
+func groupByDay(matches []models.Match) map[string][]models.Match {
+	byDay := make(map[string][]models.Match)
+	for _, match := range matches {
+		day := match.Time().UTC().Format("2006-01-02")
+		byDay[day] = append(byDay[day], match)
+	}
+	for _, dayMatches := range byDay {
+		sort.Slice(dayMatches, func(i, j int) bool {
+			return dayMatches[i].Time().Before(dayMatches[j].Time())
+		})
+	}
+	return byDay
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"liguain/backend/services"
+)
+
+// GameServices looks up the running GameService for a game id, so the bet
+// handler can place a bet through the same instance that's scoring the
+// game live.
+type GameServices interface {
+	Get(gameID string) (*services.GameService, bool)
+}
+
+// Server mounts the HTTP/REST surface over a GameService: league
+// schedules, game status/leaderboard, placing bets, and a live event
+// stream backed by each GameService's own UpdateBroker.
+type Server struct {
+	games        services.GameRepository
+	gameServices GameServices
+	players      *services.Players
+	schedule     ScheduleProvider
+	router       *mux.Router
+}
+
+// NewServer wires up the routes described by this package's handlers.
+// trustedProxies lists the networks (e.g. a load balancer or reverse proxy)
+// allowed to set X-Forwarded-For on a player's behalf; see clientIP. A nil
+// or empty list means no caller is trusted and r.RemoteAddr is always used.
+func NewServer(games services.GameRepository, gameServices GameServices, players *services.Players, schedule ScheduleProvider, trustedProxies []*net.IPNet) *Server {
+	s := &Server{games: games, gameServices: gameServices, players: players, schedule: schedule}
+
+	router := mux.NewRouter()
+	router.Use(loggingMiddleware)
+	router.HandleFunc("/leagues/{leagueID}/schedule", s.handleSchedule).Methods(http.MethodGet)
+	router.HandleFunc("/games/{gameID}", s.handleGame).Methods(http.MethodGet)
+	router.HandleFunc("/games/{gameID}/events", s.handleEvents).Methods(http.MethodGet)
+
+	router.Handle("/games/{gameID}/bets", authMiddleware(players, trustedProxies)(http.HandlerFunc(s.handlePostBet))).Methods(http.MethodPost)
+
+	s.router = router
+	return s
+}
+
+// Router returns the http.Handler Server mounts its routes on.
+func (s *Server) Router() http.Handler {
+	return s.router
+}
+
+func muxVar(r *http.Request, name string) string {
+	return mux.Vars(r)[name]
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,338 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"liguain/backend/models"
+	"liguain/backend/rules"
+	"liguain/backend/services"
+)
+
+var matchTime = time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC)
+
+type noopScorer struct{}
+
+func (noopScorer) Score(match models.Match, bets []*models.Bet) []int {
+	scores := make([]int, len(bets))
+	for i, bet := range bets {
+		if bet.IsBetCorrect() {
+			scores[i] = 500
+		}
+	}
+	return scores
+}
+
+type fakeWatcher struct{}
+
+func (fakeWatcher) WatchMatches(matches []models.Match) {}
+
+func (fakeWatcher) GetUpdates(ctx context.Context, done chan services.MatchWatcherServiceResult) {
+	<-ctx.Done()
+}
+
+// singleUpdateWatcher sends a single match update the first time GetUpdates
+// is called, then blocks on ctx for every later call, so a test can drive
+// GameService.Play through exactly one scored match.
+type singleUpdateWatcher struct {
+	update map[string]models.Match
+
+	mu   sync.Mutex
+	sent bool
+}
+
+func (w *singleUpdateWatcher) WatchMatches(matches []models.Match) {}
+
+func (w *singleUpdateWatcher) GetUpdates(ctx context.Context, done chan services.MatchWatcherServiceResult) {
+	w.mu.Lock()
+	alreadySent := w.sent
+	w.sent = true
+	w.mu.Unlock()
+
+	if alreadySent {
+		<-ctx.Done()
+		return
+	}
+
+	select {
+	case done <- services.MatchWatcherServiceResult{Value: w.update}:
+	case <-ctx.Done():
+	}
+}
+
+type fakeGameRepository struct {
+	games map[string]rules.Game
+}
+
+func (f *fakeGameRepository) GetGame(gameId string) (rules.Game, error) {
+	game, ok := f.games[gameId]
+	if !ok {
+		return nil, fmt.Errorf("game %q not found", gameId)
+	}
+	return game, nil
+}
+
+func (f *fakeGameRepository) SaveGame(game rules.Game) (string, error) {
+	f.games[game.Id()] = game
+	return game.Id(), nil
+}
+
+func (f *fakeGameRepository) UpdateScores(match models.Match, scores map[models.Player]int) error {
+	return nil
+}
+
+type fakeBetRepository struct{}
+
+func (fakeBetRepository) GetBets(gameId string, player models.Player) ([]models.Bet, error) {
+	return nil, nil
+}
+func (fakeBetRepository) SaveBet(bet models.Bet) (string, error) { return "bet-id", nil }
+func (fakeBetRepository) GetBetsForMatch(match models.Match) ([]models.Bet, error) {
+	return nil, nil
+}
+
+type fakeScheduleProvider struct {
+	matches []models.Match
+	err     error
+}
+
+func (f fakeScheduleProvider) UpcomingMatches(leagueID string) ([]models.Match, error) {
+	return f.matches, f.err
+}
+
+type fakeGameServices struct {
+	services map[string]*services.GameService
+}
+
+func (f fakeGameServices) Get(gameID string) (*services.GameService, bool) {
+	gs, ok := f.services[gameID]
+	return gs, ok
+}
+
+// newTestServer builds a Server around a single game, played by a
+// GameService that never sees any match updates (fakeWatcher blocks until
+// its context is done), so tests can exercise handlers against a known,
+// unfinished game.
+func newTestServer(t *testing.T, game rules.Game) *Server {
+	t.Helper()
+
+	repo := &fakeGameRepository{games: map[string]rules.Game{game.Id(): game}}
+	betRepo := fakeBetRepository{}
+	gameService, err := services.NewGameService(game, repo, betRepo, fakeWatcher{}, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create game service: %v", err)
+	}
+
+	players := services.NewPlayers(services.NewInMemoryPlayerRepository())
+	gameServices := fakeGameServices{services: map[string]*services.GameService{game.Id(): gameService}}
+
+	return NewServer(repo, gameServices, players, fakeScheduleProvider{}, nil)
+}
+
+func TestHandlers(t *testing.T) {
+	cases := []struct {
+		name       string
+		setup      func(t *testing.T) (*Server, *http.Request)
+		wantStatus int
+		check      func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name: "schedule groups matches by kickoff day",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				match1 := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+				match2 := models.NewSeasonMatch("Team3", "Team4", "2024", "Premier League", matchTime.Add(24*time.Hour), 2)
+				repo := &fakeGameRepository{games: map[string]rules.Game{}}
+				players := services.NewPlayers(services.NewInMemoryPlayerRepository())
+				schedule := fakeScheduleProvider{matches: []models.Match{match2, match1}}
+				server := NewServer(repo, fakeGameServices{services: map[string]*services.GameService{}}, players, schedule, nil)
+
+				req := httptest.NewRequest(http.MethodGet, "/leagues/"+url.PathEscape("Premier League")+"/schedule", nil)
+				return server, req
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var byDay map[string][]json.RawMessage
+				if err := json.Unmarshal(rec.Body.Bytes(), &byDay); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				if len(byDay) != 2 {
+					t.Errorf("Expected matches grouped into 2 days, got %d", len(byDay))
+				}
+			},
+		},
+		{
+			name: "game returns status and leaderboard",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+				players := []models.Player{{Name: "Player1"}, {Name: "Player2"}}
+				game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, noopScorer{})
+				server := newTestServer(t, game)
+
+				req := httptest.NewRequest(http.MethodGet, "/games/"+game.Id(), nil)
+				return server, req
+			},
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				var body gameStatusResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatalf("Failed to decode response: %v", err)
+				}
+				if body.Finished {
+					t.Error("Expected a freshly created game to not be finished")
+				}
+				if len(body.Leaderboard) != 2 || body.Leaderboard["Player1"] != 0 || body.Leaderboard["Player2"] != 0 {
+					t.Errorf("Expected a leaderboard with both players on 0 points, got %v", body.Leaderboard)
+				}
+			},
+		},
+		{
+			name: "unknown game returns 404",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				repo := &fakeGameRepository{games: map[string]rules.Game{}}
+				players := services.NewPlayers(services.NewInMemoryPlayerRepository())
+				server := NewServer(repo, fakeGameServices{services: map[string]*services.GameService{}}, players, fakeScheduleProvider{}, nil)
+
+				req := httptest.NewRequest(http.MethodGet, "/games/does-not-exist", nil)
+				return server, req
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "bet accepted before match time",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", time.Now().Add(time.Hour), 1)
+				players := []models.Player{{Name: "Player1"}}
+				game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, noopScorer{})
+				server := newTestServer(t, game)
+
+				body, _ := json.Marshal(placeBetRequest{PlayerID: "Player1", MatchID: match.Id(), HomeGoals: 2, AwayGoals: 1})
+				req := httptest.NewRequest(http.MethodPost, "/games/"+game.Id()+"/bets", bytes.NewReader(body))
+				req.Header.Set("X-Player-ID", "Player1")
+				req.Header.Set("X-Player-Client", "web")
+				return server, req
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "bet rejected after match time",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", time.Now().Add(-time.Hour), 1)
+				players := []models.Player{{Name: "Player1"}}
+				game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, noopScorer{})
+				server := newTestServer(t, game)
+
+				body, _ := json.Marshal(placeBetRequest{PlayerID: "Player1", MatchID: match.Id(), HomeGoals: 2, AwayGoals: 1})
+				req := httptest.NewRequest(http.MethodPost, "/games/"+game.Id()+"/bets", bytes.NewReader(body))
+				req.Header.Set("X-Player-ID", "Player1")
+				req.Header.Set("X-Player-Client", "web")
+				return server, req
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "bet missing auth header returns 401",
+			setup: func(t *testing.T) (*Server, *http.Request) {
+				match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", time.Now().Add(time.Hour), 1)
+				players := []models.Player{{Name: "Player1"}}
+				game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, noopScorer{})
+				server := newTestServer(t, game)
+
+				body, _ := json.Marshal(placeBetRequest{PlayerID: "Player1", MatchID: match.Id(), HomeGoals: 2, AwayGoals: 1})
+				req := httptest.NewRequest(http.MethodPost, "/games/"+game.Id()+"/bets", bytes.NewReader(body))
+				return server, req
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, req := tc.setup(t)
+			rec := httptest.NewRecorder()
+			server.Router().ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tc.check != nil {
+				tc.check(t, rec)
+			}
+		})
+	}
+}
+
+// TestHandleEvents_StreamsGameEventsUntilClientDisconnects drives a real
+// GameService through a single scored match and checks that the SSE
+// handler both writes the resulting MatchFinished and BetsScored events to
+// the stream and returns once the client disconnects.
+func TestHandleEvents_StreamsGameEventsUntilClientDisconnects(t *testing.T) {
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, noopScorer{})
+
+	finishedMatch := models.NewFinishedSeasonMatch("Team1", "Team2", 2, 1, "2024", "Premier League", matchTime, 1, 1.0, 2.0, 3.0)
+	watcher := &singleUpdateWatcher{update: map[string]models.Match{match.Id(): finishedMatch}}
+
+	repo := &fakeGameRepository{games: map[string]rules.Game{game.Id(): game}}
+	betRepo := fakeBetRepository{}
+	gameService, err := services.NewGameService(game, repo, betRepo, watcher, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to create game service: %v", err)
+	}
+
+	gameService.PlaceBetFromSession(models.NewBet(match, 2, 1), players[0], "test-session", matchTime.Add(-time.Second))
+
+	players2 := services.NewPlayers(services.NewInMemoryPlayerRepository())
+	gameServices := fakeGameServices{services: map[string]*services.GameService{game.Id(): gameService}}
+	server := NewServer(repo, gameServices, players2, fakeScheduleProvider{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/games/"+game.Id()+"/events", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	handlerDone := make(chan struct{})
+	go func() {
+		server.Router().ServeHTTP(rec, req)
+		close(handlerDone)
+	}()
+
+	// Make sure the handler has subscribed before the match is played out,
+	// so the one BetsScored event it produces isn't published (and
+	// dropped) before anyone is listening.
+	time.Sleep(20 * time.Millisecond)
+	go gameService.Play()
+
+	// Give the watcher's single update a few ticks to reach the handler
+	// and be written to the stream before disconnecting.
+	time.Sleep(300 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-handlerDone:
+	case <-time.After(time.Second):
+		t.Fatal("handleEvents did not return after its client disconnected")
+	}
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Expected Content-Type text/event-stream, got %q", ct)
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "event: MatchFinished") {
+		t.Errorf("Expected the stream to contain a MatchFinished event, got body: %q", body)
+	}
+	if !strings.Contains(body, "event: BetsScored") || !strings.Contains(body, `"Player1":500`) {
+		t.Errorf("Expected the stream to contain a BetsScored event crediting Player1 with 500 points, got body: %q", body)
+	}
+}
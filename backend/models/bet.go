@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// Bet is a player's prediction for a single match's final score, placed
+// before kickoff and scored once the match is finished.
+type Bet struct {
+	match     Match
+	player    Player
+	homeGoals int
+	awayGoals int
+	placedAt  time.Time
+	sessionID string
+}
+
+// NewBet creates a Bet predicting homeGoals-awayGoals for match.
+func NewBet(match Match, homeGoals, awayGoals int) Bet {
+	return Bet{match: match, homeGoals: homeGoals, awayGoals: awayGoals}
+}
+
+func (b Bet) Match() Match            { return b.match }
+func (b Bet) Player() Player          { return b.player }
+func (b Bet) PlacedAt() time.Time     { return b.placedAt }
+func (b Bet) PredictedHomeGoals() int { return b.homeGoals }
+func (b Bet) PredictedAwayGoals() int { return b.awayGoals }
+func (b Bet) SessionID() string       { return b.sessionID }
+
+// SetMatch repoints b at match, so a bet placed against a scheduled
+// SeasonMatch can be resolved against its finished counterpart once the
+// result is known.
+func (b *Bet) SetMatch(match Match) { b.match = match }
+
+// SetPlayer stamps the player who placed b.
+func (b *Bet) SetPlayer(player Player) { b.player = player }
+
+// SetPlacedAt stamps when b was placed.
+func (b *Bet) SetPlacedAt(at time.Time) { b.placedAt = at }
+
+// SetSessionID stamps the session id the bet was placed from, so a later
+// audit or anti-cheat pass can tell which device it originated from.
+func (b *Bet) SetSessionID(sessionID string) { b.sessionID = sessionID }
+
+// IsBetCorrect reports whether the predicted 1X2 result (home win, away
+// win or draw) matches the match's actual winner. Only meaningful once
+// the match is finished.
+func (b Bet) IsBetCorrect() bool {
+	return b.predictedResult() == b.match.GetWinner()
+}
+
+// IsScoreCorrect reports whether the predicted score matches the match's
+// actual final score exactly.
+func (b Bet) IsScoreCorrect() bool {
+	return b.homeGoals == b.match.HomeGoals() && b.awayGoals == b.match.AwayGoals()
+}
+
+func (b Bet) predictedResult() string {
+	switch {
+	case b.homeGoals > b.awayGoals:
+		return b.match.HomeTeam()
+	case b.awayGoals > b.homeGoals:
+		return b.match.AwayTeam()
+	default:
+		return "Draw"
+	}
+}
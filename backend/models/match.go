@@ -0,0 +1,117 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Match is a single fixture within a season/competition, before or after
+// it has been played. NewSeasonMatch creates the scheduled version and
+// NewFinishedSeasonMatch the played one; both share the same Id so a Bet
+// placed against the scheduled fixture still resolves against its played
+// counterpart.
+type Match interface {
+	Id() string
+	Season() string
+	Competition() string
+	HomeTeam() string
+	AwayTeam() string
+	HomeGoals() int
+	AwayGoals() int
+	HomeOdds() float64
+	DrawOdds() float64
+	AwayOdds() float64
+	Time() time.Time
+	Matchday() int
+	IsFinished() bool
+	// GetWinner returns the winning team's name, or "Draw" if the match
+	// ended level. It is only meaningful once IsFinished reports true.
+	GetWinner() string
+}
+
+// SeasonMatch is the only Match implementation: a fixture identified by
+// its season, competition, matchday and teams, optionally carrying its
+// final score and closing odds once it has been played.
+type SeasonMatch struct {
+	id          string
+	homeTeam    string
+	awayTeam    string
+	season      string
+	competition string
+	matchTime   time.Time
+	matchday    int
+
+	finished  bool
+	homeGoals int
+	awayGoals int
+
+	homeOdds float64
+	drawOdds float64
+	awayOdds float64
+}
+
+// NewSeasonMatch creates a not-yet-played fixture.
+func NewSeasonMatch(homeTeam, awayTeam, season, competition string, matchTime time.Time, matchday int) Match {
+	return &SeasonMatch{
+		id:          matchId(season, competition, matchday, homeTeam, awayTeam),
+		homeTeam:    homeTeam,
+		awayTeam:    awayTeam,
+		season:      season,
+		competition: competition,
+		matchTime:   matchTime,
+		matchday:    matchday,
+	}
+}
+
+// NewFinishedSeasonMatch creates a fixture that has already been played,
+// carrying its final score and the odds it closed at.
+func NewFinishedSeasonMatch(homeTeam, awayTeam string, homeGoals, awayGoals int, season, competition string, matchTime time.Time, matchday int, homeOdds, drawOdds, awayOdds float64) Match {
+	return &SeasonMatch{
+		id:          matchId(season, competition, matchday, homeTeam, awayTeam),
+		homeTeam:    homeTeam,
+		awayTeam:    awayTeam,
+		season:      season,
+		competition: competition,
+		matchTime:   matchTime,
+		matchday:    matchday,
+		finished:    true,
+		homeGoals:   homeGoals,
+		awayGoals:   awayGoals,
+		homeOdds:    homeOdds,
+		drawOdds:    drawOdds,
+		awayOdds:    awayOdds,
+	}
+}
+
+// matchId derives a stable identity from the fixture's season, competition,
+// matchday and teams, so a scheduled SeasonMatch and its later finished
+// counterpart share the same id even though they're different values.
+func matchId(season, competition string, matchday int, homeTeam, awayTeam string) string {
+	return fmt.Sprintf("%s-%s-%d-%s-vs-%s", season, competition, matchday, homeTeam, awayTeam)
+}
+
+func (m *SeasonMatch) Id() string          { return m.id }
+func (m *SeasonMatch) Season() string      { return m.season }
+func (m *SeasonMatch) Competition() string { return m.competition }
+func (m *SeasonMatch) HomeTeam() string    { return m.homeTeam }
+func (m *SeasonMatch) AwayTeam() string    { return m.awayTeam }
+func (m *SeasonMatch) HomeGoals() int      { return m.homeGoals }
+func (m *SeasonMatch) AwayGoals() int      { return m.awayGoals }
+func (m *SeasonMatch) HomeOdds() float64   { return m.homeOdds }
+func (m *SeasonMatch) DrawOdds() float64   { return m.drawOdds }
+func (m *SeasonMatch) AwayOdds() float64   { return m.awayOdds }
+func (m *SeasonMatch) Time() time.Time     { return m.matchTime }
+func (m *SeasonMatch) Matchday() int       { return m.matchday }
+func (m *SeasonMatch) IsFinished() bool    { return m.finished }
+
+// GetWinner returns the winning team's name, or "Draw" on a level score.
+func (m *SeasonMatch) GetWinner() string {
+	switch {
+	case m.homeGoals > m.awayGoals:
+		return m.homeTeam
+	case m.awayGoals > m.homeGoals:
+		return m.awayTeam
+	default:
+		return "Draw"
+	}
+}
@@ -0,0 +1,6 @@
+package models
+
+// Player identifies a participant in a Game by name.
+type Player struct {
+	Name string
+}
@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"liguain/backend/models"
+)
+
+// Game tracks a season/competition's matches, players and running
+// leaderboard, scoring each match's bets as it's marked finished.
+type Game interface {
+	Id() string
+	Season() string
+	Competition() string
+	Players() []models.Player
+	Matches() []models.Match
+	ScorerMode() ScorerMode
+	// Score delegates to the Game's Scorer to turn a finished match and the
+	// bets placed on it into points, without recording them.
+	Score(match models.Match, bets []*models.Bet) []int
+	// UpdateScores records points already awarded for match, adding them to
+	// each player's running total and marking the match finished.
+	UpdateScores(match models.Match, scores map[models.Player]int) error
+	// Scores returns the current running total for every player.
+	Scores() map[models.Player]int
+	// IsFinished reports whether every match has had scores recorded.
+	IsFinished() bool
+}
+
+type gameImpl struct {
+	season      string
+	competition string
+	players     []models.Player
+	matches     []models.Match
+	scorer      Scorer
+	scorerMode  ScorerMode
+
+	mu       sync.Mutex
+	scores   map[models.Player]int
+	finished map[string]bool
+}
+
+// NewGame creates a Game for season/competition, scoring each match's bets
+// with scorer.
+func NewGame(season, competition string, players []models.Player, matches []models.Match, scorer Scorer) Game {
+	scores := make(map[models.Player]int, len(players))
+	for _, p := range players {
+		scores[p] = 0
+	}
+	return &gameImpl{
+		season:      season,
+		competition: competition,
+		players:     players,
+		matches:     matches,
+		scorer:      scorer,
+		scorerMode:  scorerModeOf(scorer),
+		scores:      scores,
+		finished:    map[string]bool{},
+	}
+}
+
+// scorerModeOf reports the ScorerMode that reconstructs scorer via
+// NewScorer, so a Game's metadata can be persisted and replayed without
+// its repository needing to know about every Scorer implementation.
+func scorerModeOf(scorer Scorer) ScorerMode {
+	if _, ok := scorer.(*OddsWeightedScorer); ok {
+		return ScorerModeOddsWeighted
+	}
+	return ScorerModeClassic
+}
+
+func (g *gameImpl) Id() string {
+	return strings.ToLower(strings.ReplaceAll(fmt.Sprintf("%s-%s", g.season, g.competition), " ", "-"))
+}
+
+func (g *gameImpl) Season() string           { return g.season }
+func (g *gameImpl) Competition() string      { return g.competition }
+func (g *gameImpl) Players() []models.Player { return g.players }
+func (g *gameImpl) Matches() []models.Match  { return g.matches }
+func (g *gameImpl) ScorerMode() ScorerMode   { return g.scorerMode }
+
+func (g *gameImpl) Score(match models.Match, bets []*models.Bet) []int {
+	return g.scorer.Score(match, bets)
+}
+
+func (g *gameImpl) UpdateScores(match models.Match, scores map[models.Player]int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for player, points := range scores {
+		g.scores[player] += points
+	}
+	g.finished[match.Id()] = true
+	return nil
+}
+
+func (g *gameImpl) Scores() map[models.Player]int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	scores := make(map[models.Player]int, len(g.scores))
+	for player, points := range g.scores {
+		scores[player] = points
+	}
+	return scores
+}
+
+func (g *gameImpl) IsFinished() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, m := range g.matches {
+		if !g.finished[m.Id()] {
+			return false
+		}
+	}
+	return true
+}
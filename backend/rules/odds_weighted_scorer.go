@@ -0,0 +1,77 @@
+package rules
+
+import "liguain/backend/models"
+
+// Default tuning for OddsWeightedScorer, matching the base points used by
+// the classic scorer so the two modes stay comparable on safe, heavily
+// favored picks.
+const (
+	defaultBasePoints        = 500
+	defaultPerfectBonusMin   = 1.0
+	defaultPerfectBonusMax   = 5.0
+	defaultPerfectBonusScale = 0.5
+)
+
+// OddsWeightedScorer scores a correct 1X2 pick proportionally to how
+// unlikely the realized outcome was: base points are multiplied by the
+// odds of the result that actually happened, so predicting an upset pays
+// off far more than predicting a heavy favorite. A correct exact score
+// additionally earns a perfect_bonus = clamp(k * odds_realized, min, max),
+// bounded so a single lucky scoreline can't dominate the leaderboard.
+type OddsWeightedScorer struct {
+	BasePoints      int
+	PerfectBonusMin float64
+	PerfectBonusMax float64
+	PerfectBonusK   float64
+}
+
+// NewOddsWeightedScorer builds an OddsWeightedScorer using the repo's
+// default tuning.
+func NewOddsWeightedScorer() *OddsWeightedScorer {
+	return &OddsWeightedScorer{
+		BasePoints:      defaultBasePoints,
+		PerfectBonusMin: defaultPerfectBonusMin,
+		PerfectBonusMax: defaultPerfectBonusMax,
+		PerfectBonusK:   defaultPerfectBonusScale,
+	}
+}
+
+func (s *OddsWeightedScorer) Score(match models.Match, bets []*models.Bet) []int {
+	scores := make([]int, len(bets))
+	oddsRealized := realizedOdds(match)
+	for i, bet := range bets {
+		if !bet.IsBetCorrect() {
+			continue
+		}
+		points := float64(s.BasePoints) * oddsRealized
+		if bet.IsScoreCorrect() {
+			points += s.perfectBonus(oddsRealized)
+		}
+		scores[i] = int(points)
+	}
+	return scores
+}
+
+func (s *OddsWeightedScorer) perfectBonus(oddsRealized float64) float64 {
+	bonus := s.PerfectBonusK * oddsRealized
+	if bonus < s.PerfectBonusMin {
+		return s.PerfectBonusMin
+	}
+	if bonus > s.PerfectBonusMax {
+		return s.PerfectBonusMax
+	}
+	return bonus
+}
+
+// realizedOdds returns the odds of the outcome that actually happened, so
+// a correct bet on the side that was less likely to win is worth more.
+func realizedOdds(match models.Match) float64 {
+	switch match.GetWinner() {
+	case match.HomeTeam():
+		return match.HomeOdds()
+	case match.AwayTeam():
+		return match.AwayOdds()
+	default:
+		return match.DrawOdds()
+	}
+}
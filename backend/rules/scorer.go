@@ -0,0 +1,34 @@
+package rules
+
+import "liguain/backend/models"
+
+// Scorer turns a finished match and the bets placed on it into points, one
+// per bet in the same order.
+type Scorer interface {
+	Score(match models.Match, bets []*models.Bet) []int
+}
+
+// classicBasePoints is awarded for a correct 1X2 pick under ClassicScorer.
+const classicBasePoints = 500
+
+// ClassicScorer is the flat, odds-agnostic scorer: every correct 1X2 pick
+// is worth the same number of points regardless of how likely the result
+// was.
+type ClassicScorer struct {
+	BasePoints int
+}
+
+// NewClassicScorer builds a ClassicScorer using the repo's default tuning.
+func NewClassicScorer() *ClassicScorer {
+	return &ClassicScorer{BasePoints: classicBasePoints}
+}
+
+func (s *ClassicScorer) Score(match models.Match, bets []*models.Bet) []int {
+	scores := make([]int, len(bets))
+	for i, bet := range bets {
+		if bet.IsBetCorrect() {
+			scores[i] = s.BasePoints
+		}
+	}
+	return scores
+}
@@ -0,0 +1,36 @@
+package rules
+
+import "fmt"
+
+// ScorerMode selects which Scorer implementation a Game is created with.
+type ScorerMode string
+
+const (
+	// ScorerModeClassic is the flat, odds-agnostic scoring used historically.
+	ScorerModeClassic ScorerMode = "classic"
+	// ScorerModeOddsWeighted rewards correctly predicted upsets over safe
+	// favorites, see OddsWeightedScorer.
+	ScorerModeOddsWeighted ScorerMode = "odds-weighted"
+)
+
+// ScorerConfig picks the scoring mode a Game is created with. The zero
+// value selects ScorerModeClassic.
+type ScorerConfig struct {
+	Mode ScorerMode
+}
+
+// NewScorer builds the Scorer described by cfg. It is the only supported
+// way to turn a ScorerConfig into a Scorer, so a caller wiring ScorerConfig
+// into NewGame (e.g. FSGameRepository.GetGame, rebuilding a game from its
+// persisted metadata) and a caller passing a Scorer directly can never
+// drift apart on what a given mode actually means.
+func NewScorer(cfg ScorerConfig) (Scorer, error) {
+	switch cfg.Mode {
+	case "", ScorerModeClassic:
+		return NewClassicScorer(), nil
+	case ScorerModeOddsWeighted:
+		return NewOddsWeightedScorer(), nil
+	default:
+		return nil, fmt.Errorf("rules: unknown scorer mode %q", cfg.Mode)
+	}
+}
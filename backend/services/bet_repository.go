@@ -0,0 +1,14 @@
+package services
+
+import "liguain/backend/models"
+
+// BetRepository stores the bets placed on a game, parallel to how
+// GameRepository stores the game itself.
+type BetRepository interface {
+	// GetBets returns every bet player has placed in game gameId.
+	GetBets(gameId string, player models.Player) ([]models.Bet, error)
+	// SaveBet records bet and returns an id for it.
+	SaveBet(bet models.Bet) (string, error)
+	// GetBetsForMatch returns every bet placed on match, across all players.
+	GetBetsForMatch(match models.Match) ([]models.Bet, error)
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+
+	"liguain/backend/models"
+)
+
+// BrokerBackedMatchWatcher wraps a MatchWatcherService and publishes every
+// match update it observes onto an UpdateBroker topic, in addition to
+// delivering it through GetUpdates exactly as before. This is what lets a
+// WebSocket handler, a notification worker or any other consumer subscribe
+// to the same live updates GameService.Play drains, without any of them
+// blocking each other or GameService.Play itself.
+type BrokerBackedMatchWatcher struct {
+	watcher MatchWatcherService
+	broker  *UpdateBroker
+	topic   string
+}
+
+// NewBrokerBackedMatchWatcher wraps watcher so every update it produces is
+// also published to broker under topic.
+func NewBrokerBackedMatchWatcher(watcher MatchWatcherService, broker *UpdateBroker, topic string) *BrokerBackedMatchWatcher {
+	return &BrokerBackedMatchWatcher{watcher: watcher, broker: broker, topic: topic}
+}
+
+func (w *BrokerBackedMatchWatcher) WatchMatches(matches []models.Match) {
+	w.watcher.WatchMatches(matches)
+}
+
+func (w *BrokerBackedMatchWatcher) GetUpdates(ctx context.Context, done chan MatchWatcherServiceResult) {
+	inner := make(chan MatchWatcherServiceResult, 1)
+	w.watcher.GetUpdates(ctx, inner)
+
+	select {
+	case <-ctx.Done():
+		return
+	case result := <-inner:
+		if result.Err == nil {
+			for _, match := range result.Value {
+				w.broker.Publish(w.topic, eventForMatch(match))
+			}
+		}
+		select {
+		case <-ctx.Done():
+		case done <- result:
+		}
+	}
+}
+
+// eventForMatch classifies a match update as MatchFinished or ScoreChanged
+// depending on whether the match has completed.
+func eventForMatch(match models.Match) Event {
+	eventType := ScoreChanged
+	if match.IsFinished() {
+		eventType = MatchFinished
+	}
+	return Event{Type: eventType, Payload: match}
+}
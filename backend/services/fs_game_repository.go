@@ -0,0 +1,479 @@
+package services
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"liguain/backend/models"
+	"liguain/backend/rules"
+
+	"github.com/spf13/afero"
+)
+
+// osAppendFlags opens (or creates) a ledger file for append-only writes.
+const osAppendFlags = os.O_APPEND | os.O_CREATE | os.O_WRONLY
+
+// gameMetadata is the immutable record written once per game to
+// games/<gameId>/game.json. It holds everything needed to rebuild the Game
+// via rules.NewScorer and rules.NewGame.
+type gameMetadata struct {
+	GameId      string           `json:"gameId"`
+	Season      string           `json:"season"`
+	Competition string           `json:"competition"`
+	Players     []models.Player  `json:"players"`
+	Matches     []matchMetadata  `json:"matches"`
+	ScorerMode  rules.ScorerMode `json:"scorerMode"`
+}
+
+// matchMetadata is the JSON-friendly representation of a models.Match,
+// which is an interface and so can't be (un)marshaled directly.
+type matchMetadata struct {
+	HomeTeam    string    `json:"homeTeam"`
+	AwayTeam    string    `json:"awayTeam"`
+	Season      string    `json:"season"`
+	Competition string    `json:"competition"`
+	MatchTime   time.Time `json:"matchTime"`
+	Matchday    int       `json:"matchday"`
+	Finished    bool      `json:"finished"`
+	HomeGoals   int       `json:"homeGoals"`
+	AwayGoals   int       `json:"awayGoals"`
+	HomeOdds    float64   `json:"homeOdds"`
+	DrawOdds    float64   `json:"drawOdds"`
+	AwayOdds    float64   `json:"awayOdds"`
+}
+
+func toMatchMetadata(m models.Match) matchMetadata {
+	return matchMetadata{
+		HomeTeam:    m.HomeTeam(),
+		AwayTeam:    m.AwayTeam(),
+		Season:      m.Season(),
+		Competition: m.Competition(),
+		MatchTime:   m.Time(),
+		Matchday:    m.Matchday(),
+		Finished:    m.IsFinished(),
+		HomeGoals:   m.HomeGoals(),
+		AwayGoals:   m.AwayGoals(),
+		HomeOdds:    m.HomeOdds(),
+		DrawOdds:    m.DrawOdds(),
+		AwayOdds:    m.AwayOdds(),
+	}
+}
+
+func (m matchMetadata) toMatch() models.Match {
+	if m.Finished {
+		return models.NewFinishedSeasonMatch(m.HomeTeam, m.AwayTeam, m.HomeGoals, m.AwayGoals, m.Season, m.Competition, m.MatchTime, m.Matchday, m.HomeOdds, m.DrawOdds, m.AwayOdds)
+	}
+	return models.NewSeasonMatch(m.HomeTeam, m.AwayTeam, m.Season, m.Competition, m.MatchTime, m.Matchday)
+}
+
+// betLogEntry is one line of games/<gameId>/bets/<player>.jsonl: a record
+// of a single updateBet call.
+type betLogEntry struct {
+	Time      time.Time `json:"time"`
+	MatchId   string    `json:"matchId"`
+	HomeGoals int       `json:"homeGoals"`
+	AwayGoals int       `json:"awayGoals"`
+}
+
+// pointsLogEntry is one line of games/<gameId>/points.log: either a single
+// (match, player, points, reason) tuple awarded by the scorer, or a
+// Finished sentinel recording that a match finished even though it
+// produced no points entries (nobody bet on it).
+type pointsLogEntry struct {
+	MatchId  string `json:"matchId"`
+	Player   string `json:"player,omitempty"`
+	Points   int    `json:"points,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+	Finished bool   `json:"finished,omitempty"`
+}
+
+// FSGameRepository persists games to a directory tree via an injected
+// afero.Fs, mirroring the layout used by MOTH-style scoring servers:
+// immutable metadata, a per-player bet log and a single append-only points
+// ledger that GetGame replays to reconstruct standings. It also implements
+// the bet-storage side of a game (SaveBet/GetBets/GetBetsForMatch) so a
+// single filesystem tree backs both repositories.
+type FSGameRepository struct {
+	fs      afero.Fs
+	baseDir string
+
+	mu          sync.Mutex
+	matchToGame map[string]string
+}
+
+// NewFSGameRepository opens (or creates) a repository rooted at baseDir on
+// fs and scans it for any games already on disk.
+func NewFSGameRepository(fs afero.Fs, baseDir string) (*FSGameRepository, error) {
+	if err := fs.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating games directory: %w", err)
+	}
+	r := &FSGameRepository{fs: fs, baseDir: baseDir, matchToGame: map[string]string{}}
+	if err := r.refresh(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *FSGameRepository) gameDir(gameId string) string {
+	return filepath.Join(r.baseDir, gameId)
+}
+
+// refresh rescans the filesystem and rebuilds the match-to-game index, so
+// games created or edited outside this process (another instance, manual
+// repair) are picked up.
+func (r *FSGameRepository) refresh() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries, err := afero.ReadDir(r.fs, r.baseDir)
+	if err != nil {
+		return fmt.Errorf("scanning games directory: %w", err)
+	}
+
+	matchToGame := map[string]string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		meta, err := r.readMetadata(entry.Name())
+		if err != nil {
+			return fmt.Errorf("reading metadata for game %s: %w", entry.Name(), err)
+		}
+		for _, m := range meta.Matches {
+			matchToGame[m.toMatch().Id()] = meta.GameId
+		}
+	}
+	r.matchToGame = matchToGame
+	return nil
+}
+
+func (r *FSGameRepository) readMetadata(gameId string) (*gameMetadata, error) {
+	raw, err := afero.ReadFile(r.fs, filepath.Join(r.gameDir(gameId), "game.json"))
+	if err != nil {
+		return nil, err
+	}
+	var meta gameMetadata
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// SaveGame writes the immutable game.json and players.txt for game, and
+// returns the id it was saved under.
+func (r *FSGameRepository) SaveGame(game rules.Game) (string, error) {
+	gameId := game.Id()
+	dir := r.gameDir(gameId)
+	if err := r.fs.MkdirAll(filepath.Join(dir, "bets"), 0o755); err != nil {
+		return "", fmt.Errorf("creating game directory: %w", err)
+	}
+
+	matches := make([]matchMetadata, len(game.Matches()))
+	for i, m := range game.Matches() {
+		matches[i] = toMatchMetadata(m)
+	}
+
+	meta := gameMetadata{
+		GameId:      gameId,
+		Season:      game.Season(),
+		Competition: game.Competition(),
+		Players:     game.Players(),
+		Matches:     matches,
+		ScorerMode:  game.ScorerMode(),
+	}
+	raw, err := json.Marshal(meta)
+	if err != nil {
+		return "", fmt.Errorf("encoding game metadata: %w", err)
+	}
+	if err := afero.WriteFile(r.fs, filepath.Join(dir, "game.json"), raw, 0o644); err != nil {
+		return "", fmt.Errorf("writing game metadata: %w", err)
+	}
+
+	var playerLines []byte
+	for _, p := range meta.Players {
+		playerLines = append(playerLines, []byte(p.Name+"\n")...)
+	}
+	if err := afero.WriteFile(r.fs, filepath.Join(dir, "players.txt"), playerLines, 0o644); err != nil {
+		return "", fmt.Errorf("writing players list: %w", err)
+	}
+
+	r.mu.Lock()
+	for _, m := range meta.Matches {
+		r.matchToGame[m.toMatch().Id()] = gameId
+	}
+	r.mu.Unlock()
+
+	return gameId, nil
+}
+
+// GetGame rebuilds a game from its immutable metadata and replays
+// points.log on top of it, so the returned Game reflects every score ever
+// awarded even if this process never saw the matches play out itself.
+func (r *FSGameRepository) GetGame(gameId string) (rules.Game, error) {
+	meta, err := r.readMetadata(gameId)
+	if err != nil {
+		return nil, fmt.Errorf("reading game metadata: %w", err)
+	}
+
+	matches := make([]models.Match, len(meta.Matches))
+	for i, m := range meta.Matches {
+		matches[i] = m.toMatch()
+	}
+
+	scorer, err := rules.NewScorer(rules.ScorerConfig{Mode: meta.ScorerMode})
+	if err != nil {
+		return nil, fmt.Errorf("resolving scorer: %w", err)
+	}
+	game := rules.NewGame(meta.Season, meta.Competition, meta.Players, matches, scorer)
+
+	matchesById := make(map[string]models.Match, len(matches))
+	for _, m := range matches {
+		matchesById[m.Id()] = m
+	}
+
+	entries, err := r.readPointsLog(gameId)
+	if err != nil {
+		return nil, err
+	}
+	byMatch := make(map[string]map[models.Player]int)
+	for _, e := range entries {
+		scores, ok := byMatch[e.MatchId]
+		if !ok {
+			scores = make(map[models.Player]int)
+			byMatch[e.MatchId] = scores
+		}
+		if e.Player != "" {
+			scores[models.Player{Name: e.Player}] += e.Points
+		}
+	}
+	for matchId, scores := range byMatch {
+		match, ok := matchesById[matchId]
+		if !ok {
+			return nil, fmt.Errorf("points.log references unknown match %q", matchId)
+		}
+		if err := game.UpdateScores(match, scores); err != nil {
+			return nil, fmt.Errorf("replaying scores for match %s: %w", matchId, err)
+		}
+	}
+
+	return game, nil
+}
+
+// gameIdForMatch looks up which game a match belongs to, refreshing the
+// index once if it's missing in case the game was saved by another
+// FSGameRepository instance on the same filesystem.
+func (r *FSGameRepository) gameIdForMatch(matchId string) (string, error) {
+	r.mu.Lock()
+	gameId, ok := r.matchToGame[matchId]
+	r.mu.Unlock()
+	if ok {
+		return gameId, nil
+	}
+	if err := r.refresh(); err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	gameId, ok = r.matchToGame[matchId]
+	r.mu.Unlock()
+	if !ok {
+		return "", fmt.Errorf("no saved game contains match %q", matchId)
+	}
+	return gameId, nil
+}
+
+// UpdateScores appends one points.log line per (player, points) pair
+// awarded for match, tagged with a reason derived from the match result so
+// the ledger stays human-auditable. It always also appends a Finished
+// sentinel line for match, so a match nobody bet on (scores is empty)
+// still leaves a trace that it finished instead of vanishing from the
+// ledger entirely.
+func (r *FSGameRepository) UpdateScores(match models.Match, scores map[models.Player]int) error {
+	gameId, err := r.gameIdForMatch(match.Id())
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(r.gameDir(gameId), "points.log")
+	file, err := r.fs.OpenFile(path, osAppendFlags, 0o644)
+	if err != nil {
+		return fmt.Errorf("opening points ledger: %w", err)
+	}
+	defer file.Close()
+
+	reason := fmt.Sprintf("match %s finished %s", match.Id(), match.GetWinner())
+	entries := make([]pointsLogEntry, 0, len(scores)+1)
+	for player, points := range scores {
+		entries = append(entries, pointsLogEntry{
+			MatchId: match.Id(),
+			Player:  player.Name,
+			Points:  points,
+			Reason:  reason,
+		})
+	}
+	entries = append(entries, pointsLogEntry{
+		MatchId:  match.Id(),
+		Reason:   reason,
+		Finished: true,
+	})
+
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("encoding points ledger entry: %w", err)
+		}
+		if _, err := file.Write(append(raw, '\n')); err != nil {
+			return fmt.Errorf("appending to points ledger: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *FSGameRepository) readPointsLog(gameId string) ([]pointsLogEntry, error) {
+	path := filepath.Join(r.gameDir(gameId), "points.log")
+	exists, err := afero.Exists(r.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("checking points ledger: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	file, err := r.fs.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening points ledger: %w", err)
+	}
+	defer file.Close()
+
+	var entries []pointsLogEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry pointsLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("decoding points ledger entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading points ledger: %w", err)
+	}
+	return entries, nil
+}
+
+// SaveBet appends bet to games/<gameId>/bets/<player>.jsonl, timestamped
+// with the bet's PlacedAt time.
+func (r *FSGameRepository) SaveBet(bet models.Bet) (string, error) {
+	gameId, err := r.gameIdForMatch(bet.Match().Id())
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(r.gameDir(gameId), "bets", bet.Player().Name+".jsonl")
+	file, err := r.fs.OpenFile(path, osAppendFlags, 0o644)
+	if err != nil {
+		return "", fmt.Errorf("opening bet log: %w", err)
+	}
+	defer file.Close()
+
+	entry := betLogEntry{
+		Time:      bet.PlacedAt(),
+		MatchId:   bet.Match().Id(),
+		HomeGoals: bet.PredictedHomeGoals(),
+		AwayGoals: bet.PredictedAwayGoals(),
+	}
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return "", fmt.Errorf("encoding bet log entry: %w", err)
+	}
+	if _, err := file.Write(append(raw, '\n')); err != nil {
+		return "", fmt.Errorf("appending to bet log: %w", err)
+	}
+	return fmt.Sprintf("%s:%s:%d", gameId, bet.Player().Name, bet.PlacedAt().UnixNano()), nil
+}
+
+// GetBets replays games/<gameId>/bets/<player>.jsonl into the bets that
+// player placed in that game.
+func (r *FSGameRepository) GetBets(gameId string, player models.Player) ([]models.Bet, error) {
+	path := filepath.Join(r.gameDir(gameId), "bets", player.Name+".jsonl")
+	exists, err := afero.Exists(r.fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("checking bet log: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	meta, err := r.readMetadata(gameId)
+	if err != nil {
+		return nil, fmt.Errorf("reading game metadata for bet replay: %w", err)
+	}
+	matchesById := make(map[string]models.Match, len(meta.Matches))
+	for _, m := range meta.Matches {
+		match := m.toMatch()
+		matchesById[match.Id()] = match
+	}
+
+	file, err := r.fs.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening bet log: %w", err)
+	}
+	defer file.Close()
+
+	var bets []models.Bet
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry betLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("decoding bet log entry: %w", err)
+		}
+		match, ok := matchesById[entry.MatchId]
+		if !ok {
+			return nil, fmt.Errorf("bet log references unknown match %q", entry.MatchId)
+		}
+		bets = append(bets, models.NewBet(match, entry.HomeGoals, entry.AwayGoals))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading bet log: %w", err)
+	}
+	return bets, nil
+}
+
+// GetBetsForMatch scans every player's bet log for bets placed on match.
+func (r *FSGameRepository) GetBetsForMatch(match models.Match) ([]models.Bet, error) {
+	gameId, err := r.gameIdForMatch(match.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(r.gameDir(gameId), "bets")
+	entries, err := afero.ReadDir(r.fs, dir)
+	if err != nil {
+		return nil, fmt.Errorf("scanning bet logs: %w", err)
+	}
+
+	var bets []models.Bet
+	for _, entry := range entries {
+		player := models.Player{Name: stripJsonlExt(entry.Name())}
+		playerBets, err := r.GetBets(gameId, player)
+		if err != nil {
+			return nil, err
+		}
+		for _, bet := range playerBets {
+			if bet.Match().Id() == match.Id() {
+				bets = append(bets, bet)
+			}
+		}
+	}
+	return bets, nil
+}
+
+func stripJsonlExt(name string) string {
+	const ext = ".jsonl"
+	if len(name) > len(ext) && name[len(name)-len(ext):] == ext {
+		return name[:len(name)-len(ext)]
+	}
+	return name
+}
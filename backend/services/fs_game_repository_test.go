@@ -0,0 +1,163 @@
+package services
+
+import (
+	"testing"
+
+	"liguain/backend/models"
+	"liguain/backend/rules"
+
+	"github.com/spf13/afero"
+)
+
+func TestFSGameRepository_SaveGame_GetGame_ReplaysLedger(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	repo, err := NewFSGameRepository(fs, "games")
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}, {Name: "Player2"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, &ScorerMock{})
+
+	gameId, err := repo.SaveGame(game)
+	if err != nil {
+		t.Fatalf("Failed to save game: %v", err)
+	}
+
+	finishedMatch := models.NewFinishedSeasonMatch("Team1", "Team2", 2, 1, "2024", "Premier League", matchTime, 1, 1.0, 2.0, 3.0)
+	if err := repo.UpdateScores(finishedMatch, map[models.Player]int{players[0]: 500, players[1]: 0}); err != nil {
+		t.Fatalf("Failed to record scores: %v", err)
+	}
+
+	entries, err := repo.readPointsLog(gameId)
+	if err != nil {
+		t.Fatalf("Failed to read points ledger: %v", err)
+	}
+	totals := map[string]int{}
+	for _, e := range entries {
+		totals[e.Player] += e.Points
+	}
+	if totals["Player1"] != 500 {
+		t.Errorf("Expected Player1 to have 500 points in the ledger, got %d", totals["Player1"])
+	}
+	if totals["Player2"] != 0 {
+		t.Errorf("Expected Player2 to have 0 points in the ledger, got %d", totals["Player2"])
+	}
+
+	reloaded, err := repo.GetGame(gameId)
+	if err != nil {
+		t.Fatalf("Failed to reload game: %v", err)
+	}
+	if reloaded.Season() != game.Season() || reloaded.Competition() != game.Competition() {
+		t.Errorf("Expected reloaded game metadata to match the original")
+	}
+	if !reloaded.IsFinished() {
+		t.Errorf("Expected reloaded game to be finished")
+	}
+	scores := reloaded.Scores()
+	if scores[players[0]] != 500 {
+		t.Errorf("Expected Player1 to have 500 points after replay, got %d", scores[players[0]])
+	}
+	if scores[players[1]] != 0 {
+		t.Errorf("Expected Player2 to have 0 points after replay, got %d", scores[players[1]])
+	}
+}
+
+// TestFSGameRepository_UpdateScores_MatchWithNoBetsStillMarksFinished covers
+// a match nobody bet on: UpdateScores is called with an empty scores map,
+// and the match must still come back finished after a reload, instead of
+// silently disappearing from the ledger because it produced no points
+// entries.
+func TestFSGameRepository_UpdateScores_MatchWithNoBetsStillMarksFinished(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	repo, err := NewFSGameRepository(fs, "games")
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, &ScorerMock{})
+
+	gameId, err := repo.SaveGame(game)
+	if err != nil {
+		t.Fatalf("Failed to save game: %v", err)
+	}
+
+	finishedMatch := models.NewFinishedSeasonMatch("Team1", "Team2", 2, 1, "2024", "Premier League", matchTime, 1, 1.0, 2.0, 3.0)
+	if err := repo.UpdateScores(finishedMatch, map[models.Player]int{}); err != nil {
+		t.Fatalf("Failed to record scores: %v", err)
+	}
+
+	reloaded, err := repo.GetGame(gameId)
+	if err != nil {
+		t.Fatalf("Failed to reload game: %v", err)
+	}
+	if !reloaded.IsFinished() {
+		t.Errorf("Expected a match nobody bet on to still be marked finished after replay")
+	}
+}
+
+func TestFSGameRepository_SaveBet_ThenGetBets(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	repo, err := NewFSGameRepository(fs, "games")
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, &ScorerMock{})
+	gameId, err := repo.SaveGame(game)
+	if err != nil {
+		t.Fatalf("Failed to save game: %v", err)
+	}
+
+	bet := models.NewBet(match, 2, 1)
+	bet.SetPlayer(players[0])
+	if _, err := repo.SaveBet(bet); err != nil {
+		t.Fatalf("Failed to save bet: %v", err)
+	}
+
+	bets, err := repo.GetBets(gameId, players[0])
+	if err != nil {
+		t.Fatalf("Failed to get bets: %v", err)
+	}
+	if len(bets) != 1 {
+		t.Fatalf("Expected 1 bet, got %d", len(bets))
+	}
+
+	matchBets, err := repo.GetBetsForMatch(match)
+	if err != nil {
+		t.Fatalf("Failed to get bets for match: %v", err)
+	}
+	if len(matchBets) != 1 {
+		t.Fatalf("Expected 1 bet for the match, got %d", len(matchBets))
+	}
+}
+
+func TestFSGameRepository_Refresh_PicksUpGamesSavedByAnotherInstance(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	writer, err := NewFSGameRepository(fs, "games")
+	if err != nil {
+		t.Fatalf("Failed to create writer repository: %v", err)
+	}
+
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, &ScorerMock{})
+	if _, err := writer.SaveGame(game); err != nil {
+		t.Fatalf("Failed to save game: %v", err)
+	}
+
+	reader, err := NewFSGameRepository(fs, "games")
+	if err != nil {
+		t.Fatalf("Failed to create reader repository: %v", err)
+	}
+
+	finishedMatch := models.NewFinishedSeasonMatch("Team1", "Team2", 1, 0, "2024", "Premier League", matchTime, 1, 1.0, 2.0, 3.0)
+	if err := reader.UpdateScores(finishedMatch, map[models.Player]int{players[0]: 500}); err != nil {
+		t.Fatalf("Expected reader to resolve the match through a previously saved game, got: %v", err)
+	}
+}
@@ -10,5 +10,8 @@ type GameRepository interface {
 	GetGame(gameId string) (rules.Game, error)
 	// SaveGame saves a game and returns the game id, and an error if saving failed
 	SaveGame(game rules.Game) (string, error)
-	updateScores(scores map[models.Player]int) error
+	// UpdateScores records the points awarded to each player for a single
+	// match, so a repository can keep a per-match audit trail instead of
+	// just the running total.
+	UpdateScores(match models.Match, scores map[models.Player]int) error
 }
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"liguain/backend/models"
+	"liguain/backend/rules"
+)
+
+// playerBet is a single player's bet on a single match, tracked by
+// GameService until the match finishes and it can be scored.
+type playerBet struct {
+	bet    models.Bet
+	player models.Player
+}
+
+// GameService plays a single Game to completion: it watches the game's
+// matches for results, scores each one as it finishes, and reports the
+// winner(s) once every match is done. Every match update it sees along the
+// way is also published on its own UpdateBroker, under a topic equal to the
+// game's Id, so callers such as the HTTP API's SSE endpoint can watch the
+// game live without being wired into watcher directly.
+type GameService struct {
+	game     rules.Game
+	repo     GameRepository
+	betRepo  BetRepository
+	watcher  MatchWatcherService
+	broker   *UpdateBroker
+	interval time.Duration
+
+	mu   sync.Mutex
+	bets map[string][]playerBet // matchId -> bets placed on it
+}
+
+// NewGameService builds a GameService for game, polling watcher every
+// interval for match updates. watcher is wrapped so every update it produces
+// is also published on the GameService's broker; see Events.
+func NewGameService(game rules.Game, repo GameRepository, betRepo BetRepository, watcher MatchWatcherService, interval time.Duration) (*GameService, error) {
+	if game == nil {
+		return nil, fmt.Errorf("game is required")
+	}
+	if watcher == nil {
+		return nil, fmt.Errorf("watcher is required")
+	}
+	broker := NewUpdateBroker(BrokerConfig{})
+	return &GameService{
+		game:     game,
+		repo:     repo,
+		betRepo:  betRepo,
+		watcher:  NewBrokerBackedMatchWatcher(watcher, broker, game.Id()),
+		broker:   broker,
+		interval: interval,
+		bets:     map[string][]playerBet{},
+	}, nil
+}
+
+// Events returns a channel of this game's live match updates, as published
+// by watcher through the GameService's broker. Delivery stops and the
+// channel is closed once ctx is done.
+func (s *GameService) Events(ctx context.Context) <-chan Event {
+	return s.broker.Subscribe(ctx, s.game.Id())
+}
+
+// updateBet records (or replaces) player's bet for the match it targets,
+// persisting it to betRepo if one is configured.
+func (s *GameService) updateBet(bet models.Bet, player models.Player, placedAt time.Time) {
+	bet.SetPlayer(player)
+	bet.SetPlacedAt(placedAt)
+
+	s.mu.Lock()
+	matchId := bet.Match().Id()
+	bets := s.bets[matchId]
+	replaced := false
+	for i, existing := range bets {
+		if existing.player == player {
+			bets[i] = playerBet{bet: bet, player: player}
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		bets = append(bets, playerBet{bet: bet, player: player})
+	}
+	s.bets[matchId] = bets
+	s.mu.Unlock()
+
+	if s.betRepo != nil {
+		_, _ = s.betRepo.SaveBet(bet)
+	}
+}
+
+// Play watches the game's matches until every one of them is finished and
+// scored, then returns the player(s) with the highest total score.
+func (s *GameService) Play() ([]models.Player, error) {
+	s.watcher.WatchMatches(s.game.Matches())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for !s.game.IsFinished() {
+		<-ticker.C
+
+		done := make(chan MatchWatcherServiceResult)
+		go s.watcher.GetUpdates(ctx, done)
+		result := <-done
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		for _, match := range result.Value {
+			if !match.IsFinished() {
+				continue
+			}
+			if err := s.scoreMatch(match); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return s.winners(), nil
+}
+
+// scoreMatch repoints every bet placed on match at its finished result,
+// scores them with the game's scorer, and records the outcome.
+func (s *GameService) scoreMatch(match models.Match) error {
+	s.mu.Lock()
+	bets := s.bets[match.Id()]
+	s.mu.Unlock()
+
+	betPtrs := make([]*models.Bet, len(bets))
+	players := make([]models.Player, len(bets))
+	for i := range bets {
+		bets[i].bet.SetMatch(match)
+		betPtrs[i] = &bets[i].bet
+		players[i] = bets[i].player
+	}
+
+	points := s.game.Score(match, betPtrs)
+	scores := make(map[models.Player]int, len(players))
+	for i, player := range players {
+		scores[player] += points[i]
+	}
+
+	if err := s.game.UpdateScores(match, scores); err != nil {
+		return fmt.Errorf("recording scores for match %s: %w", match.Id(), err)
+	}
+	if s.repo != nil {
+		if err := s.repo.UpdateScores(match, scores); err != nil {
+			return fmt.Errorf("persisting scores for match %s: %w", match.Id(), err)
+		}
+	}
+
+	// scores is keyed by models.Player, which isn't a valid JSON map key,
+	// so re-key it by player name for publishing.
+	scoresByName := make(map[string]int, len(scores))
+	for player, points := range scores {
+		scoresByName[player.Name] = points
+	}
+	s.broker.Publish(s.game.Id(), Event{Type: BetsScored, Payload: scoresByName})
+	return nil
+}
+
+// winners returns the player(s) with the highest total score.
+func (s *GameService) winners() []models.Player {
+	scores := s.game.Scores()
+
+	best := 0
+	first := true
+	for _, points := range scores {
+		if first || points > best {
+			best = points
+			first = false
+		}
+	}
+
+	var winners []models.Player
+	for player, points := range scores {
+		if points == best {
+			winners = append(winners, player)
+		}
+	}
+	return winners
+}
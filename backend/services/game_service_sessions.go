@@ -0,0 +1,17 @@
+package services
+
+import (
+	"time"
+
+	"liguain/backend/models"
+)
+
+// PlaceBetFromSession behaves like updateBet, but first stamps the bet
+// with the session it was placed from, so a later audit or anti-cheat pass
+// can tell which device a bet originated from. It is exported so callers
+// outside this package (such as the HTTP API) can place bets without
+// reaching into GameService's unexported internals.
+func (s *GameService) PlaceBetFromSession(bet models.Bet, player models.Player, sessionID string, placedAt time.Time) {
+	bet.SetSessionID(sessionID)
+	s.updateBet(bet, player, placedAt)
+}
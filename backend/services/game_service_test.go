@@ -10,6 +10,91 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// oddsWeightedLeaderboardCase builds a small season where a "safe" player
+// correctly picks two favorites and an "underdog" player correctly picks a
+// single big upset, then plays it through the given scorer.
+func oddsWeightedLeaderboardCase(t *testing.T, scorer rules.Scorer) ([]models.Player, []models.Player) {
+	t.Helper()
+
+	favoriteMatch1 := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	favoriteMatch2 := models.NewSeasonMatch("Team3", "Team4", "2024", "Premier League", matchTime.Add(time.Hour), 2)
+	upsetMatch := models.NewSeasonMatch("Team5", "Team6", "2024", "Premier League", matchTime.Add(2*time.Hour), 3)
+
+	safePlayer := models.Player{Name: "SafePicker"}
+	underdogPlayer := models.Player{Name: "UnderdogPicker"}
+	players := []models.Player{safePlayer, underdogPlayer}
+	matches := []models.Match{favoriteMatch1, favoriteMatch2, upsetMatch}
+
+	game := rules.NewGame("2024", "Premier League", players, matches, scorer)
+
+	updates := []map[string]models.Match{
+		{favoriteMatch1.Id(): models.NewFinishedSeasonMatch("Team1", "Team2", 1, 0, "2024", "Premier League", matchTime, 1, 1.2, 5.0, 12.0)},
+		{favoriteMatch2.Id(): models.NewFinishedSeasonMatch("Team3", "Team4", 2, 0, "2024", "Premier League", matchTime.Add(time.Hour), 2, 1.3, 4.5, 10.0)},
+		{upsetMatch.Id(): models.NewFinishedSeasonMatch("Team5", "Team6", 0, 3, "2024", "Premier League", matchTime.Add(2*time.Hour), 3, 1.1, 6.0, 15.0)},
+	}
+
+	repo := &GameRepositoryMock{}
+	betRepo := &BetRepositoryMock{}
+	service, err := NewGameService(game, repo, betRepo, NewMatchWatcherServiceMock(updates), 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to create game service: %v", err)
+	}
+	service.watcher = NewMatchWatcherServiceMock(updates)
+
+	// Safe picker nails both favorites, misses the upset.
+	service.updateBet(models.NewBet(favoriteMatch1, 1, 0), safePlayer, matchTime.Add(-1*time.Second))
+	service.updateBet(models.NewBet(favoriteMatch2, 2, 0), safePlayer, matchTime)
+	service.updateBet(models.NewBet(upsetMatch, 2, 0), safePlayer, matchTime.Add(time.Hour))
+
+	// Underdog picker misses both favorites, nails the upset exactly.
+	service.updateBet(models.NewBet(favoriteMatch1, 0, 1), underdogPlayer, matchTime.Add(-1*time.Second))
+	service.updateBet(models.NewBet(favoriteMatch2, 1, 1), underdogPlayer, matchTime)
+	service.updateBet(models.NewBet(upsetMatch, 0, 3), underdogPlayer, matchTime.Add(time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	var winners []models.Player
+	var playErr error
+
+	go func() {
+		winners, playErr = service.Play()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Play function timed out after 1 second")
+	case <-done:
+		if playErr != nil {
+			t.Fatalf("Failed to play game: %v", playErr)
+		}
+	}
+
+	if !game.IsFinished() {
+		t.Errorf("Expected game to be finished after all matches are played")
+	}
+
+	return []models.Player{safePlayer, underdogPlayer}, winners
+}
+
+func TestGameService_Play_ClassicScorer_SafePickerWins(t *testing.T) {
+	players, winners := oddsWeightedLeaderboardCase(t, rules.NewClassicScorer())
+
+	if len(winners) != 1 || winners[0].Name != players[0].Name {
+		t.Errorf("Expected %s to win under the classic scorer, got %v", players[0].Name, winners)
+	}
+}
+
+func TestGameService_Play_OddsWeightedScorer_UnderdogOvertakesSafePicker(t *testing.T) {
+	players, winners := oddsWeightedLeaderboardCase(t, rules.NewOddsWeightedScorer())
+
+	if len(winners) != 1 || winners[0].Name != players[1].Name {
+		t.Errorf("Expected %s to win under the odds-weighted scorer, got %v", players[1].Name, winners)
+	}
+}
+
 var matchTime = time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC)
 
 // Mock implementations
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+)
+
+// InMemoryPlayerRepository is a PlayerRepository backed by a map, used in
+// tests the same way BetRepositoryMock stands in for a real BetRepository.
+type InMemoryPlayerRepository struct {
+	mu       sync.Mutex
+	sessions map[string]*PlayerSession
+}
+
+// NewInMemoryPlayerRepository builds an empty InMemoryPlayerRepository.
+func NewInMemoryPlayerRepository() *InMemoryPlayerRepository {
+	return &InMemoryPlayerRepository{sessions: map[string]*PlayerSession{}}
+}
+
+func (r *InMemoryPlayerRepository) FindSession(gameID, playerID, client, userAgent string) (*PlayerSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, session := range r.sessions {
+		if session.GameID == gameID && session.PlayerID == playerID && session.Client == client && session.UserAgent == userAgent {
+			clone := *session
+			return &clone, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *InMemoryPlayerRepository) GetSession(id string) (*PlayerSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("no session with id %q", id)
+	}
+	clone := *session
+	return &clone, nil
+}
+
+func (r *InMemoryPlayerRepository) SaveSession(session *PlayerSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *session
+	r.sessions[session.ID] = &clone
+	return nil
+}
+
+func (r *InMemoryPlayerRepository) ListSessions(gameID string) ([]*PlayerSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sessions []*PlayerSession
+	for _, session := range r.sessions {
+		if session.GameID == gameID {
+			clone := *session
+			sessions = append(sessions, &clone)
+		}
+	}
+	return sessions, nil
+}
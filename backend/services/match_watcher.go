@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"liguain/backend/models"
+)
+
+// MatchWatcherServiceResult is what GetUpdates sends on its done channel:
+// every match that changed since the last poll, keyed by id, or an error.
+type MatchWatcherServiceResult struct {
+	Value map[string]models.Match
+	Err   error
+}
+
+// MatchWatcherService watches a fixed set of matches for score/status
+// changes and reports them to GameService.Play.
+type MatchWatcherService interface {
+	// WatchMatches tells the watcher which matches to poll.
+	WatchMatches(matches []models.Match)
+	// GetUpdates sends the matches that changed since the last call on
+	// done, or blocks until ctx is done.
+	GetUpdates(ctx context.Context, done chan MatchWatcherServiceResult)
+}
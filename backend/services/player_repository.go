@@ -0,0 +1,19 @@
+package services
+
+// PlayerRepository stores PlayerSession records so Players can reuse an
+// existing session for a given (game, player, client, user agent)
+// combination instead of creating a duplicate one, parallel to how
+// BetRepository stores bets.
+type PlayerRepository interface {
+	// FindSession returns the session matching (gameID, playerID, client,
+	// userAgent), or nil if none has been registered yet.
+	FindSession(gameID, playerID, client, userAgent string) (*PlayerSession, error)
+	// GetSession returns the session with the given id. If no session has
+	// that id, it returns a non-nil error rather than a nil session with a
+	// nil error.
+	GetSession(id string) (*PlayerSession, error)
+	// SaveSession creates or updates a session.
+	SaveSession(session *PlayerSession) error
+	// ListSessions returns every session registered for gameID.
+	ListSessions(gameID string) ([]*PlayerSession, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import "time"
+
+// PlayerSession is a registered device/session for a player in a game,
+// modelled on Navidrome's Player: the same (game, player, client, user
+// agent) combination reuses one session across requests instead of piling
+// up a fresh record every time that device shows up.
+type PlayerSession struct {
+	ID        string
+	GameID    string
+	PlayerID  string
+	Client    string
+	UserAgent string
+	IPAddress string
+	LastSeen  time.Time
+}
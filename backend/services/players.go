@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Players registers and tracks the devices/sessions a player connects
+// from, modelled on Navidrome's Players service: the same (game, player,
+// client, user agent) combination reuses one session and just has its
+// LastSeen refreshed, instead of accumulating a new session on every
+// request.
+type Players struct {
+	repo PlayerRepository
+	now  func() time.Time
+}
+
+// NewPlayers builds a Players service backed by repo.
+func NewPlayers(repo PlayerRepository) *Players {
+	return &Players{repo: repo, now: time.Now}
+}
+
+// Register creates a session for (gameID, playerID, client, userAgent), or
+// reuses and refreshes the matching one if it already exists.
+func (p *Players) Register(ctx context.Context, gameID, playerID, client, userAgent, ip string) (*PlayerSession, error) {
+	existing, err := p.repo.FindSession(gameID, playerID, client, userAgent)
+	if err != nil {
+		return nil, fmt.Errorf("looking up existing session: %w", err)
+	}
+	if existing != nil {
+		existing.IPAddress = ip
+		existing.LastSeen = p.now()
+		if err := p.repo.SaveSession(existing); err != nil {
+			return nil, fmt.Errorf("refreshing session: %w", err)
+		}
+		return existing, nil
+	}
+
+	session := &PlayerSession{
+		ID:        fmt.Sprintf("%s:%s:%s:%s", gameID, playerID, client, userAgent),
+		GameID:    gameID,
+		PlayerID:  playerID,
+		Client:    client,
+		UserAgent: userAgent,
+		IPAddress: ip,
+		LastSeen:  p.now(),
+	}
+	if err := p.repo.SaveSession(session); err != nil {
+		return nil, fmt.Errorf("saving session: %w", err)
+	}
+	return session, nil
+}
+
+// Touch refreshes the LastSeen timestamp of the session with id.
+func (p *Players) Touch(ctx context.Context, id string) error {
+	session, err := p.repo.GetSession(id)
+	if err != nil {
+		return fmt.Errorf("looking up session: %w", err)
+	}
+	if session == nil {
+		return fmt.Errorf("no session with id %q", id)
+	}
+	session.LastSeen = p.now()
+	return p.repo.SaveSession(session)
+}
+
+// List returns every session registered for gameID, for a "who's online"
+// presence view.
+func (p *Players) List(ctx context.Context, gameID string) ([]*PlayerSession, error) {
+	return p.repo.ListSessions(gameID)
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPlayers_Register_CreatesSessionIfNotFound(t *testing.T) {
+	players := NewPlayers(NewInMemoryPlayerRepository())
+
+	session, err := players.Register(context.Background(), "game-1", "Player1", "web", "Mozilla/5.0", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to register session: %v", err)
+	}
+	if session.ID == "" {
+		t.Fatal("Expected a non-empty session id")
+	}
+	if session.LastSeen.IsZero() {
+		t.Error("Expected LastSeen to be stamped")
+	}
+
+	sessions, err := players.List(context.Background(), "game-1")
+	if err != nil {
+		t.Fatalf("Failed to list sessions: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("Expected 1 session, got %d", len(sessions))
+	}
+}
+
+func TestPlayers_Register_ReusesMatchingSession(t *testing.T) {
+	players := NewPlayers(NewInMemoryPlayerRepository())
+
+	first, err := players.Register(context.Background(), "game-1", "Player1", "web", "Mozilla/5.0", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to register session: %v", err)
+	}
+
+	second, err := players.Register(context.Background(), "game-1", "Player1", "web", "Mozilla/5.0", "10.0.0.2")
+	if err != nil {
+		t.Fatalf("Failed to re-register session: %v", err)
+	}
+
+	if first.ID != second.ID {
+		t.Errorf("Expected the same device to reuse its session id, got %q and %q", first.ID, second.ID)
+	}
+	if second.IPAddress != "10.0.0.2" {
+		t.Errorf("Expected the reused session to pick up the new IP, got %q", second.IPAddress)
+	}
+
+	sessions, err := players.List(context.Background(), "game-1")
+	if err != nil {
+		t.Fatalf("Failed to list sessions: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("Expected the matching session to be reused rather than duplicated, got %d sessions", len(sessions))
+	}
+}
+
+func TestPlayers_Register_DifferentClientGetsItsOwnSession(t *testing.T) {
+	players := NewPlayers(NewInMemoryPlayerRepository())
+
+	web, err := players.Register(context.Background(), "game-1", "Player1", "web", "Mozilla/5.0", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to register web session: %v", err)
+	}
+	mobile, err := players.Register(context.Background(), "game-1", "Player1", "mobile-app", "okhttp/4.0", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to register mobile session: %v", err)
+	}
+
+	if web.ID == mobile.ID {
+		t.Error("Expected distinct clients to get distinct sessions")
+	}
+
+	sessions, err := players.List(context.Background(), "game-1")
+	if err != nil {
+		t.Fatalf("Failed to list sessions: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("Expected 2 sessions, got %d", len(sessions))
+	}
+}
+
+func TestPlayers_Touch_RefreshesLastSeen(t *testing.T) {
+	players := NewPlayers(NewInMemoryPlayerRepository())
+	session, err := players.Register(context.Background(), "game-1", "Player1", "web", "Mozilla/5.0", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("Failed to register session: %v", err)
+	}
+	firstSeen := session.LastSeen
+
+	// Touch stamps LastSeen with time.Now(), which has limited resolution
+	// on some platforms, so advance the clock Players itself uses rather
+	// than relying on wall-clock time passing between these two calls.
+	touchedAt := firstSeen.Add(time.Second)
+	players.now = func() time.Time { return touchedAt }
+
+	if err := players.Touch(context.Background(), session.ID); err != nil {
+		t.Fatalf("Failed to touch session: %v", err)
+	}
+
+	sessions, err := players.List(context.Background(), "game-1")
+	if err != nil {
+		t.Fatalf("Failed to list sessions: %v", err)
+	}
+	if len(sessions) != 1 {
+		t.Fatalf("Expected 1 session, got %d", len(sessions))
+	}
+	if !sessions[0].LastSeen.Equal(touchedAt) {
+		t.Errorf("Expected Touch to advance LastSeen to %v, got %v", touchedAt, sessions[0].LastSeen)
+	}
+}
+
+func TestPlayers_Touch_UnknownSessionReturnsError(t *testing.T) {
+	players := NewPlayers(NewInMemoryPlayerRepository())
+
+	if err := players.Touch(context.Background(), "does-not-exist"); err == nil {
+		t.Error("Expected an error touching an unknown session")
+	}
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"sync"
+)
+
+// EventType identifies the kind of update an Event carries.
+type EventType string
+
+const (
+	MatchStarted  EventType = "MatchStarted"
+	ScoreChanged  EventType = "ScoreChanged"
+	MatchFinished EventType = "MatchFinished"
+	BetsScored    EventType = "BetsScored"
+)
+
+// Event is a single notification published to a topic.
+type Event struct {
+	Type    EventType
+	Topic   string
+	Payload any
+}
+
+// OverflowPolicy controls what a topic does when a subscriber's buffer is
+// full.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the
+	// new one, so a slow subscriber still sees the most recent events.
+	DropOldest OverflowPolicy = iota
+	// Disconnect closes the subscriber's channel instead of letting it
+	// fall behind, so a slow subscriber can't back-pressure the topic.
+	Disconnect
+)
+
+// defaultBufferSize is used when BrokerConfig.BufferSize is left at zero.
+const defaultBufferSize = 16
+
+// BrokerConfig tunes an UpdateBroker's per-subscriber behavior.
+type BrokerConfig struct {
+	// BufferSize is how many events are buffered per subscriber before
+	// Overflow kicks in. Zero means defaultBufferSize.
+	BufferSize int
+	// Overflow decides what happens when a subscriber can't keep up.
+	Overflow OverflowPolicy
+	// ReplaySize is how many of the most recent events on a topic are
+	// replayed to a subscriber as soon as it subscribes. Zero disables
+	// replay.
+	ReplaySize int
+}
+
+// subscriber is one Subscribe call's view of a topic.
+type subscriber struct {
+	ch chan Event
+}
+
+// topicHub fans out events published to a single topic to every current
+// subscriber from its own goroutine, so a publisher never blocks on a slow
+// subscriber for longer than the configured overflow policy allows. Unless
+// replay is configured, it is reaped by its owning UpdateBroker once its
+// last subscriber disconnects.
+type topicHub struct {
+	cfg    BrokerConfig
+	broker *UpdateBroker
+	topic  string
+
+	publish chan Event
+	stop    chan struct{}
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	replay      []Event
+}
+
+func newTopicHub(broker *UpdateBroker, topic string, cfg BrokerConfig) *topicHub {
+	h := &topicHub{
+		cfg:         cfg,
+		broker:      broker,
+		topic:       topic,
+		publish:     make(chan Event, defaultBufferSize),
+		stop:        make(chan struct{}),
+		subscribers: map[*subscriber]struct{}{},
+	}
+	go h.run()
+	return h
+}
+
+func (h *topicHub) run() {
+	for {
+		select {
+		case event := <-h.publish:
+			h.mu.Lock()
+			if h.cfg.ReplaySize > 0 {
+				h.replay = append(h.replay, event)
+				if len(h.replay) > h.cfg.ReplaySize {
+					h.replay = h.replay[len(h.replay)-h.cfg.ReplaySize:]
+				}
+			}
+			for sub := range h.subscribers {
+				h.deliver(sub, event)
+			}
+			empty := len(h.subscribers) == 0
+			h.mu.Unlock()
+
+			// A topic with no replay buffer to preserve and nobody
+			// listening right now has nothing left to do until it gains a
+			// subscriber, so reap it instead of leaking its goroutine and
+			// hubs entry for the lifetime of the broker. A hub with replay
+			// configured is left alone: a subscriber arriving later is
+			// still meant to see the buffered history.
+			if empty && h.cfg.ReplaySize == 0 {
+				h.broker.reap(h.topic, h)
+			}
+		case <-h.stop:
+			return
+		}
+	}
+}
+
+// deliver sends event to sub according to the hub's overflow policy. Must
+// be called with h.mu held.
+func (h *topicHub) deliver(sub *subscriber, event Event) {
+	select {
+	case sub.ch <- event:
+		return
+	default:
+	}
+
+	switch h.cfg.Overflow {
+	case Disconnect:
+		delete(h.subscribers, sub)
+		close(sub.ch)
+	default: // DropOldest
+		select {
+		case <-sub.ch:
+		default:
+		}
+		select {
+		case sub.ch <- event:
+		default:
+		}
+	}
+}
+
+func (h *topicHub) subscribe() *subscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	bufferSize := h.cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	sub := &subscriber{ch: make(chan Event, bufferSize)}
+	for _, event := range h.replay {
+		h.deliver(sub, event)
+	}
+	h.subscribers[sub] = struct{}{}
+	return sub
+}
+
+func (h *topicHub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	if _, ok := h.subscribers[sub]; ok {
+		delete(h.subscribers, sub)
+		close(sub.ch)
+	}
+	h.mu.Unlock()
+
+	// As in run, a hub with replay configured keeps its history for
+	// subscribers that arrive later.
+	if h.cfg.ReplaySize == 0 {
+		h.broker.reap(h.topic, h)
+	}
+}
+
+// UpdateBroker is a pub/sub hub for live match and score updates. A
+// MatchWatcherService (or anything else) publishes typed events to named
+// topics, and any number of independent subscribers can Subscribe without
+// blocking each other, each with its own bounded buffer, overflow policy
+// and optional replay of recent history.
+type UpdateBroker struct {
+	cfg BrokerConfig
+
+	mu   sync.Mutex
+	hubs map[string]*topicHub
+}
+
+// NewUpdateBroker creates an UpdateBroker that applies cfg to every topic
+// it creates.
+func NewUpdateBroker(cfg BrokerConfig) *UpdateBroker {
+	return &UpdateBroker{cfg: cfg, hubs: map[string]*topicHub{}}
+}
+
+func (b *UpdateBroker) hub(topic string) *topicHub {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	h, ok := b.hubs[topic]
+	if !ok {
+		h = newTopicHub(b, topic, b.cfg)
+		b.hubs[topic] = h
+	}
+	return h
+}
+
+// reap stops h and removes it from hubs once its last subscriber has
+// disconnected, so a finished topic doesn't leak its goroutine and map
+// entry forever. It's a no-op if h has already been replaced or gained a
+// new subscriber since the caller observed it empty.
+func (b *UpdateBroker) reap(topic string, h *topicHub) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hubs[topic] != h {
+		return
+	}
+
+	h.mu.Lock()
+	empty := len(h.subscribers) == 0
+	h.mu.Unlock()
+	if !empty {
+		return
+	}
+
+	delete(b.hubs, topic)
+	close(h.stop)
+}
+
+// Publish sends event to every current (and, with replay enabled, future)
+// subscriber of topic.
+func (b *UpdateBroker) Publish(topic string, event Event) {
+	event.Topic = topic
+	b.hub(topic).publish <- event
+}
+
+// Subscribe returns a channel of events published to topic from now on,
+// preceded by any replayed history. Delivery stops and the channel is
+// closed once ctx is done.
+func (b *UpdateBroker) Subscribe(ctx context.Context, topic string) <-chan Event {
+	h := b.hub(topic)
+	sub := h.subscribe()
+
+	go func() {
+		<-ctx.Done()
+		h.unsubscribe(sub)
+	}()
+
+	return sub.ch
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"liguain/backend/models"
+	"liguain/backend/rules"
+)
+
+func TestUpdateBroker_MultipleSubscribersSeeSameEvents(t *testing.T) {
+	broker := NewUpdateBroker(BrokerConfig{BufferSize: 4})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	subA := broker.Subscribe(ctx, "game-1")
+	subB := broker.Subscribe(ctx, "game-1")
+
+	broker.Publish("game-1", Event{Type: ScoreChanged, Payload: "1-0"})
+	broker.Publish("game-1", Event{Type: MatchFinished, Payload: "2-0"})
+
+	for _, sub := range []<-chan Event{subA, subB} {
+		var got []Event
+		for len(got) < 2 {
+			select {
+			case e := <-sub:
+				got = append(got, e)
+			case <-time.After(time.Second):
+				t.Fatalf("Timed out waiting for events, got %d so far", len(got))
+			}
+		}
+		if got[0].Type != ScoreChanged || got[1].Type != MatchFinished {
+			t.Errorf("Unexpected event order/types: %+v", got)
+		}
+	}
+}
+
+func TestUpdateBroker_ReplayDeliversRecentHistoryToLateSubscriber(t *testing.T) {
+	broker := NewUpdateBroker(BrokerConfig{BufferSize: 4, ReplaySize: 2})
+	broker.Publish("game-1", Event{Type: ScoreChanged, Payload: "1-0"})
+	broker.Publish("game-1", Event{Type: ScoreChanged, Payload: "2-0"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	late := broker.Subscribe(ctx, "game-1")
+
+	select {
+	case e := <-late:
+		if e.Payload != "1-0" {
+			t.Errorf("Expected replay to start with the oldest buffered event, got %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for replayed event")
+	}
+}
+
+func TestUpdateBroker_DropOldestOverflowKeepsLatestEvent(t *testing.T) {
+	broker := NewUpdateBroker(BrokerConfig{BufferSize: 1, Overflow: DropOldest})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := broker.Subscribe(ctx, "game-1")
+
+	broker.Publish("game-1", Event{Type: ScoreChanged, Payload: "1-0"})
+	broker.Publish("game-1", Event{Type: ScoreChanged, Payload: "2-0"})
+	time.Sleep(50 * time.Millisecond) // let the hub goroutine process both publishes
+
+	select {
+	case e := <-sub:
+		if e.Payload != "2-0" {
+			t.Errorf("Expected drop-oldest to keep the latest event, got %+v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+}
+
+func TestUpdateBroker_ReapsHubOnceLastSubscriberDisconnects(t *testing.T) {
+	broker := NewUpdateBroker(BrokerConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := broker.Subscribe(ctx, "game-1")
+	broker.Publish("game-1", Event{Type: ScoreChanged})
+	<-sub
+
+	cancel()
+	for i := 0; i < 100; i++ {
+		broker.mu.Lock()
+		_, ok := broker.hubs["game-1"]
+		broker.mu.Unlock()
+		if !ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Expected the hub for game-1 to be reaped after its last subscriber disconnected")
+}
+
+func TestUpdateBroker_ReapsHubPublishedWithNoSubscribers(t *testing.T) {
+	broker := NewUpdateBroker(BrokerConfig{})
+
+	broker.Publish("game-1", Event{Type: ScoreChanged})
+
+	for i := 0; i < 100; i++ {
+		broker.mu.Lock()
+		_, ok := broker.hubs["game-1"]
+		broker.mu.Unlock()
+		if !ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Expected the hub for game-1 to be reaped after publishing to it with no subscribers")
+}
+
+func TestGameService_Play_ThroughBrokerBackedWatcher(t *testing.T) {
+	match := models.NewSeasonMatch("Team1", "Team2", "2024", "Premier League", matchTime, 1)
+	players := []models.Player{{Name: "Player1"}, {Name: "Player2"}}
+	game := rules.NewGame("2024", "Premier League", players, []models.Match{match}, &ScorerMock{})
+
+	updates := []map[string]models.Match{
+		{match.Id(): models.NewFinishedSeasonMatch("Team1", "Team2", 2, 1, "2024", "Premier League", matchTime, 1, 1.0, 2.0, 3.0)},
+	}
+
+	broker := NewUpdateBroker(BrokerConfig{BufferSize: 4})
+	watcher := NewBrokerBackedMatchWatcher(NewMatchWatcherServiceMock(updates), broker, "game-broker-test")
+
+	subCtx, subCancel := context.WithCancel(context.Background())
+	defer subCancel()
+	events := broker.Subscribe(subCtx, "game-broker-test")
+
+	repo := &GameRepositoryMock{}
+	betRepo := &BetRepositoryMock{}
+	service, err := NewGameService(game, repo, betRepo, watcher, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to create game service: %v", err)
+	}
+	service.watcher = watcher
+
+	service.updateBet(models.NewBet(match, 2, 1), players[0], matchTime.Add(-1*time.Second))
+	service.updateBet(models.NewBet(match, 1, 1), players[1], matchTime.Add(-1*time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	done := make(chan struct{})
+	var winners []models.Player
+	var playErr error
+	go func() {
+		winners, playErr = service.Play()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Play function timed out after 1 second")
+	case <-done:
+		if playErr != nil {
+			t.Fatalf("Failed to play game: %v", playErr)
+		}
+	}
+
+	if len(winners) != 1 || winners[0].Name != "Player1" {
+		t.Errorf("Expected Player1 to win, got %v", winners)
+	}
+
+	select {
+	case e := <-events:
+		if e.Type != MatchFinished {
+			t.Errorf("Expected a MatchFinished event, got %v", e.Type)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the broker to observe the match update")
+	}
+}